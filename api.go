@@ -21,16 +21,51 @@ const (
 	FlagRetryable = internal.FlagRetryable
 	// FlagTimeout is set to represent errors indicating a timeout occurred.
 	FlagTimeout = internal.FlagTimeout
+	// FlagNotFound is set to represent errors where a resource could not be found.
+	FlagNotFound = internal.FlagNotFound
+	// FlagInvalidArgument is set to represent errors caused by a bad request/argument.
+	FlagInvalidArgument = internal.FlagInvalidArgument
+	// FlagUnauthenticated is set to represent errors where the caller's identity
+	// could not be verified.
+	FlagUnauthenticated = internal.FlagUnauthenticated
+	// FlagPermissionDenied is set to represent errors where the caller is identified
+	// but not authorized to perform the operation.
+	FlagPermissionDenied = internal.FlagPermissionDenied
+	// FlagConflict is set to represent errors where the request conflicts with the
+	// current state of the resource.
+	FlagConflict = internal.FlagConflict
+	// FlagUnavailable is set to represent errors where the service is currently
+	// unable to handle the request.
+	FlagUnavailable = internal.FlagUnavailable
+	// FlagInternal is set to represent errors caused by an internal invariant
+	// being broken.
+	FlagInternal = internal.FlagInternal
+	// FlagResourceExhausted is set to represent errors where a quota or rate
+	// limit has been exceeded.
+	FlagResourceExhausted = internal.FlagResourceExhausted
+	// FlagFailedPrecondition is set to represent errors where the system is not
+	// in a state required to perform the operation.
+	FlagFailedPrecondition = internal.FlagFailedPrecondition
+	// FlagCancelled is set to represent errors where the operation was cancelled,
+	// typically by the caller.
+	FlagCancelled = internal.FlagCancelled
 )
 
 // Type Aliases
 type (
-	Canonical = internal.Canonical
-	Error     = internal.Error
-	Extras    = internal.Extras
-	Flags     = internal.Flags
-	Group     = internal.Group
-	Grouper   = internal.Grouper
+	Canonical          = internal.Canonical
+	Code               = internal.Code
+	Error              = internal.Error
+	Extras             = internal.Extras
+	Flags              = internal.Flags
+	Frame              = internal.Frame
+	Group              = internal.Group
+	GroupFormatter     = internal.GroupFormatter
+	Grouper            = internal.Grouper
+	Namespace          = internal.Namespace
+	NamespacedRegistry = internal.NamespacedRegistry
+	Registry           = internal.Registry
+	Stack              = internal.Stack
 )
 
 // Var Aliases
@@ -38,6 +73,29 @@ var (
 	// ErrUnknown indicates the wrapped error is not well-known and not previously
 	// defined. This commonly indicates it's coming from an external system/library.
 	ErrUnknown = internal.ErrUnknown
+	// DefaultRegistry is the Registry used by Define.
+	DefaultRegistry = internal.DefaultRegistry
+)
+
+// Func Aliases
+var (
+	// Define creates a new Canonical from the given namespace, code, message,
+	// and flags, registers it in DefaultRegistry, and returns it.
+	Define = internal.Define
+	// Fields walks err's entire chain, merging every Canonical's Extras.Fields
+	// into a single map, with the outermost value winning on key conflicts.
+	Fields = internal.Fields
+	// GroupFormatterTree renders a Group's errors, and each one's Unwrap
+	// chain, as an indented tree. Assign it to Group.Formatter to use it.
+	GroupFormatterTree = internal.GroupFormatterTree
+	// New returns a new Error using DefaultNamespace and FlagUnknown, suitable
+	// for ad-hoc errors that don't warrant a dedicated Canonical definition.
+	New = internal.New
+	// NewRegistry creates a new, empty Registry.
+	NewRegistry = internal.NewRegistry
+	// SetStackCaptureEnabled toggles whether a stack trace is captured when
+	// errors are created via New or attached via Wrap/Wrapf.
+	SetStackCaptureEnabled = internal.SetStackCaptureEnabled
 )
 
 // Join one or more errors into a group.
@@ -0,0 +1,88 @@
+// Package grpcerr maps Canonical errors to/from gRPC status codes.
+//
+// It is kept as a separate package so that importing the core go-errors
+// package never pulls in the grpc-go dependency for callers that don't
+// need it.
+package grpcerr
+
+import (
+	stderrors "errors"
+
+	errors "github.com/go-stdlib/go-errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCCode walks err's chain for a Canonical and maps its Flags to a gRPC
+// status code. It returns codes.Unknown if no Canonical is found in the
+// chain, or if none of its Flags map to a more specific code.
+func GRPCCode(err error) codes.Code {
+	var ce errors.Canonical
+	if !stderrors.As(err, &ce) {
+		return codes.Unknown
+	}
+
+	switch {
+	case ce.Flags.Has(errors.FlagNotFound):
+		return codes.NotFound
+	case ce.Flags.Has(errors.FlagInvalidArgument):
+		return codes.InvalidArgument
+	case ce.Flags.Has(errors.FlagUnauthenticated):
+		return codes.Unauthenticated
+	case ce.Flags.Has(errors.FlagPermissionDenied):
+		return codes.PermissionDenied
+	case ce.Flags.Has(errors.FlagConflict):
+		return codes.Aborted
+	case ce.Flags.Has(errors.FlagFailedPrecondition):
+		return codes.FailedPrecondition
+	case ce.Flags.Has(errors.FlagResourceExhausted):
+		return codes.ResourceExhausted
+	case ce.Flags.Has(errors.FlagTimeout):
+		return codes.DeadlineExceeded
+	case ce.Flags.Has(errors.FlagCancelled):
+		return codes.Canceled
+	case ce.Flags.Has(errors.FlagUnavailable):
+		return codes.Unavailable
+	case ce.Flags.Has(errors.FlagInternal):
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
+
+// FromGRPCStatus returns a Canonical representing the given gRPC status,
+// using st.Message() as the Message.
+func FromGRPCStatus(st *status.Status) errors.Error {
+	flags := errors.FlagUnknown
+	switch st.Code() {
+	case codes.NotFound:
+		flags = errors.FlagNotFound
+	case codes.InvalidArgument:
+		flags = errors.FlagInvalidArgument
+	case codes.Unauthenticated:
+		flags = errors.FlagUnauthenticated
+	case codes.PermissionDenied:
+		flags = errors.FlagPermissionDenied
+	case codes.Aborted:
+		flags = errors.FlagConflict
+	case codes.FailedPrecondition:
+		flags = errors.FlagFailedPrecondition
+	case codes.ResourceExhausted:
+		flags = errors.FlagResourceExhausted
+	case codes.DeadlineExceeded:
+		flags = errors.FlagTimeout
+	case codes.Canceled:
+		flags = errors.FlagCancelled
+	case codes.Unavailable:
+		flags = errors.FlagUnavailable
+	case codes.Internal:
+		flags = errors.FlagInternal
+	}
+
+	return errors.Canonical{
+		Code:      errors.Code(st.Code().String()),
+		Flags:     flags,
+		Message:   st.Message(),
+		Namespace: errors.DefaultNamespace,
+	}
+}
@@ -0,0 +1,88 @@
+// Package httperr maps Canonical errors to/from HTTP status codes.
+//
+// It is kept as a separate package so that importing the core go-errors
+// package never pulls in net/http for callers that don't need it.
+package httperr
+
+import (
+	stderrors "errors"
+	"net/http"
+	"strconv"
+
+	errors "github.com/go-stdlib/go-errors"
+)
+
+// HTTPStatus walks err's chain for a Canonical and maps its Flags to an
+// HTTP status code. It returns http.StatusInternalServerError if no
+// Canonical is found in the chain, or if none of its Flags map to a more
+// specific status.
+func HTTPStatus(err error) int {
+	var ce errors.Canonical
+	if !stderrors.As(err, &ce) {
+		return http.StatusInternalServerError
+	}
+
+	switch {
+	case ce.Flags.Has(errors.FlagNotFound):
+		return http.StatusNotFound
+	case ce.Flags.Has(errors.FlagInvalidArgument):
+		return http.StatusBadRequest
+	case ce.Flags.Has(errors.FlagUnauthenticated):
+		return http.StatusUnauthorized
+	case ce.Flags.Has(errors.FlagPermissionDenied):
+		return http.StatusForbidden
+	case ce.Flags.Has(errors.FlagConflict):
+		return http.StatusConflict
+	case ce.Flags.Has(errors.FlagFailedPrecondition):
+		return http.StatusPreconditionFailed
+	case ce.Flags.Has(errors.FlagResourceExhausted):
+		return http.StatusTooManyRequests
+	case ce.Flags.Has(errors.FlagTimeout):
+		return http.StatusGatewayTimeout
+	case ce.Flags.Has(errors.FlagUnavailable):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// FromHTTPStatus returns a Canonical representing the given HTTP status
+// code, using body (if non-empty) as the Message and http.StatusText(code)
+// otherwise.
+func FromHTTPStatus(code int, body []byte) errors.Error {
+	flags := errors.FlagUnknown
+	switch code {
+	case http.StatusNotFound:
+		flags = errors.FlagNotFound
+	case http.StatusBadRequest:
+		flags = errors.FlagInvalidArgument
+	case http.StatusUnauthorized:
+		flags = errors.FlagUnauthenticated
+	case http.StatusForbidden:
+		flags = errors.FlagPermissionDenied
+	case http.StatusConflict:
+		flags = errors.FlagConflict
+	case http.StatusPreconditionFailed:
+		flags = errors.FlagFailedPrecondition
+	case http.StatusTooManyRequests:
+		flags = errors.FlagResourceExhausted
+	case http.StatusGatewayTimeout:
+		flags = errors.FlagTimeout
+	case http.StatusServiceUnavailable:
+		flags = errors.FlagUnavailable
+	case http.StatusInternalServerError:
+		flags = errors.FlagInternal
+	}
+
+	message := string(body)
+	if message == "" {
+		message = http.StatusText(code)
+	}
+
+	return errors.Canonical{
+		Code:      errors.Code(strconv.Itoa(code)),
+		Flags:     flags,
+		Message:   message,
+		Namespace: errors.DefaultNamespace,
+	}
+}
@@ -3,6 +3,7 @@ package internal
 import (
 	"fmt"
 	"sort"
+	"time"
 )
 
 // Error represents an interface to a known/defined application error.
@@ -25,8 +26,12 @@ type Error interface {
 	Key() string
 	// WithExtras returns a new copy of the error with the extras added.
 	WithExtras(Extras) Error
+	// WithField returns a new copy of the error with the given key/value field added.
+	WithField(string, any) Error
 	// WithFlags returns a new copy of the error with the given attributes applied.
 	WithFlags(Flags) Error
+	// WithRetryable returns a new copy of the error marked retryable with the given delay.
+	WithRetryable(time.Duration) Error
 	// WithTags returns a new copy of the error with the given tags applied.
 	WithTags(...string) Error
 }
@@ -1,11 +1,15 @@
 package internal
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
 )
 
 const (
@@ -103,6 +107,11 @@ func (c Canonical) Copy() Error {
 }
 
 // Equal returns true if the two Errors are equal.
+//
+// Extras.StackTrace is ignored for this comparison since it reflects the
+// call site an error was captured at rather than its identity; otherwise
+// errors.Is against a predefined Canonical would fail for any instance
+// that picked up a stack trace along the way.
 func (c Canonical) Equal(e Error) bool {
 	var ce Canonical
 	if !errors.As(e, &ce) {
@@ -112,7 +121,14 @@ func (c Canonical) Equal(e Error) bool {
 		c.Message == ce.Message &&
 		c.Namespace == ce.Namespace &&
 		c.Flags == ce.Flags &&
-		reflect.DeepEqual(c.Extras, ce.Extras)
+		extrasEqual(c.Extras, ce.Extras)
+}
+
+// extrasEqual compares two Extras for equality, ignoring StackTrace.
+func extrasEqual(a, b Extras) bool {
+	a.StackTrace = nil
+	b.StackTrace = nil
+	return reflect.DeepEqual(a, b)
 }
 
 // Key returns a value that uniquely identifies the type of error.
@@ -125,6 +141,58 @@ func (c Canonical) IsZero() bool {
 	return reflect.DeepEqual(c, new(Canonical))
 }
 
+// MarshalJSON implements the json.Marshaler interface.
+//
+// Wrapped is normally hidden from the default JSON encoding (it's tagged
+// `json:"-"` since it's only meant for machine/operator consumption), but
+// this override includes it recursively: as a nested object when the
+// wrapped error is itself a Canonical, or as {"message": err.Error()}
+// otherwise, so the full error chain appears in the JSON output for
+// observability. There is no matching UnmarshalJSON: decoding this output
+// into a Canonical does not restore Wrapped.
+func (c Canonical) MarshalJSON() ([]byte, error) {
+	type alias Canonical
+
+	out := struct {
+		alias
+		Wrapped any `json:"wrapped,omitempty"`
+	}{alias: alias(c)}
+
+	if c.Wrapped != nil {
+		var ce Canonical
+		if errors.As(c.Wrapped, &ce) {
+			out.Wrapped = ce
+		} else {
+			out.Wrapped = struct {
+				Message string `json:"message"`
+			}{Message: c.Wrapped.Error()}
+		}
+	}
+	return json.Marshal(out)
+}
+
+// StackTrace returns the resolved call stack captured when this error (or
+// the deepest error in its chain that captured one) was created, or nil
+// if stack capture was disabled or never occurred.
+func (c Canonical) StackTrace() []Frame {
+	return chainStackTrace(c).Frames()
+}
+
+// New returns a copy of the error with Message replaced and a fresh stack
+// trace captured at the call site, discarding any existing Extras and
+// Wrapped chain. This is useful to produce a distinct occurrence of a
+// predefined Canonical at the point where it actually occurred.
+func (c Canonical) New(message string) Error {
+	cp := Canonical{
+		Code:      c.Code,
+		Flags:     c.Flags,
+		Message:   message,
+		Namespace: c.Namespace,
+	}
+	cp.Extras = cp.Extras.WithStackTrace(captureStack(1))
+	return cp
+}
+
 // IsRetryable returns true if the error indicates the failed operation
 // is safe to retry.
 func (c Canonical) IsRetryable() bool { return c.Flags.Has(FlagRetryable) }
@@ -160,6 +228,57 @@ func (c Canonical) WithFlags(flags Flags) Error {
 	}
 }
 
+// WithField returns a new copy of the error with the given key/value field added.
+func (c Canonical) WithField(k string, v any) Error {
+	return Canonical{
+		Code:      c.Code,
+		Extras:    c.Extras.WithField(k, v),
+		Flags:     c.Flags,
+		Message:   c.Message,
+		Namespace: c.Namespace,
+		Wrapped:   c.Wrapped,
+	}
+}
+
+// WithRetryable returns a new copy of the error with FlagRetryable set and
+// the given retry delay recorded in Extras.Delay.
+func (c Canonical) WithRetryable(delay time.Duration) Error {
+	return Canonical{
+		Code:      c.Code,
+		Extras:    c.Extras.WithDelay(delay),
+		Flags:     c.Flags.Set(FlagRetryable),
+		Message:   c.Message,
+		Namespace: c.Namespace,
+		Wrapped:   c.Wrapped,
+	}
+}
+
+// LogValue implements slog.LogValuer, so that logging a Canonical directly
+// (e.g. slog.Error("op failed", "err", err)) emits its code, namespace,
+// flags, and merged Fields (see Fields) as structured attributes instead of
+// a single flattened message string.
+//
+// Interface: slog.LogValuer.
+func (c Canonical) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("namespace", string(c.Namespace)),
+		slog.String("code", string(c.Code)),
+		slog.String("flags", c.Flags.String()),
+	}
+
+	fields := Fields(c)
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		attrs = append(attrs, slog.Any(k, fields[k]))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
 // WithTags returns a new copy of the error with the additional tags added.
 func (c Canonical) WithTags(tags ...string) Error {
 	return Canonical{
@@ -190,6 +309,11 @@ func (c Canonical) Format(s fmt.State, verb rune) {
 			if _, err := io.WriteString(s, c.AsGroup().Error()); err != nil {
 				panic(err)
 			}
+			for _, frame := range c.StackTrace() {
+				if _, err := io.WriteString(s, fmt.Sprintf("\n%s", frame)); err != nil {
+					panic(err)
+				}
+			}
 			return
 		}
 		fallthrough
@@ -241,7 +365,23 @@ func (c Canonical) Unwrap() error {
 // is a zero value, just return a copy of the given Canonical. This
 // allows us to avoid checking this case at every call-site; we
 // can just Wrap the error and handle it.
+//
+// If no error in the resulting chain already carries a stack trace, one
+// is captured at the call site; if err already has one, it is preserved
+// so that the deepest capture wins.
 func (c Canonical) Wrap(err error) Error {
+	return c.wrap(err)
+}
+
+// Wrapf returns a new Canonical with an error created by the given format + args.
+func (c Canonical) Wrapf(format string, a ...any) Error {
+	return c.wrap(fmt.Errorf(format, a...))
+}
+
+// wrap holds the shared Wrap/Wrapf implementation. Both public methods call
+// this at the same stack depth from their caller, so a single skip value
+// captures the right frame regardless of which one was used.
+func (c Canonical) wrap(err error) Error {
 	if err == nil {
 		return c
 	}
@@ -251,7 +391,7 @@ func (c Canonical) Wrap(err error) Error {
 			return ce.Copy()
 		}
 	}
-	return Canonical{
+	wrapped := Canonical{
 		Code:      c.Code,
 		Extras:    c.Extras,
 		Flags:     c.Flags,
@@ -259,9 +399,22 @@ func (c Canonical) Wrap(err error) Error {
 		Namespace: c.Namespace,
 		Wrapped:   err,
 	}
+	if len(wrapped.Extras.StackTrace) == 0 && !hasStackTrace(err) {
+		wrapped.Extras = wrapped.Extras.WithStackTrace(captureStack(2))
+	}
+	return wrapped
 }
 
-// Wrapf returns a new Canonical with an error created by the given format + args.
-func (c Canonical) Wrapf(format string, a ...any) Error {
-	return c.Wrap(fmt.Errorf(format, a...))
+// New returns a new Error using DefaultNamespace and FlagUnknown, suitable
+// for ad-hoc errors that don't warrant a dedicated Canonical definition.
+// A stack trace is captured at the call site if enabled.
+func New(message string) Error {
+	c := Canonical{
+		Code:      ErrUnknown.Code,
+		Flags:     ErrUnknown.Flags,
+		Message:   message,
+		Namespace: ErrUnknown.Namespace,
+	}
+	c.Extras = c.Extras.WithStackTrace(captureStack(1))
+	return c
 }
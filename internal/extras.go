@@ -6,10 +6,17 @@ import "time"
 type Extras struct {
 	// Delay is the duration to wait before retrying the failed operation.
 	Delay time.Duration `json:"delay,omitempty"`
+	// Fields are arbitrary key/value pairs attached to the error, e.g. a
+	// user ID, request ID, or DB shard, for structured logging.
+	Fields map[string]any `json:"fields,omitempty"`
 	// Links to helpful documentation regarding the error.
 	Links []string `json:"links,omitempty"`
-	// StackTrace of the error.
-	StackTrace string `json:"stack_trace,omitempty"`
+	// StackTrace of the error, captured via runtime.Callers. Excluded from
+	// JSON: raw program counters are meaningless outside the exact binary/
+	// process that captured them and would leak memory addresses into
+	// ordinary error payloads. Use Canonical.StackTrace() to get resolved,
+	// symbol-level Frames within the process instead.
+	StackTrace Stack `json:"-"`
 	// Tags are additional labels that can be used to categorize errors.
 	Tags []string `json:"tags,omitempty"`
 }
@@ -18,6 +25,7 @@ type Extras struct {
 func (e Extras) WithDelay(delay time.Duration) Extras {
 	return Extras{
 		Delay:      delay,
+		Fields:     e.Fields,
 		Links:      e.Links,
 		StackTrace: e.StackTrace,
 		Tags:       e.Tags,
@@ -25,9 +33,10 @@ func (e Extras) WithDelay(delay time.Duration) Extras {
 }
 
 // WithStackTrace returns a new copy of the Extras with the stace trace.
-func (e Extras) WithStackTrace(trace string) Extras {
+func (e Extras) WithStackTrace(trace Stack) Extras {
 	return Extras{
 		Delay:      e.Delay,
+		Fields:     e.Fields,
 		Links:      e.Links,
 		StackTrace: trace,
 		Tags:       e.Tags,
@@ -38,6 +47,7 @@ func (e Extras) WithStackTrace(trace string) Extras {
 func (e Extras) WithLinks(links ...string) Extras {
 	return Extras{
 		Delay:      e.Delay,
+		Fields:     e.Fields,
 		Links:      append(e.Links, links...),
 		StackTrace: e.StackTrace,
 		Tags:       e.Tags,
@@ -48,8 +58,27 @@ func (e Extras) WithLinks(links ...string) Extras {
 func (e Extras) WithTags(tags ...string) Extras {
 	return Extras{
 		Delay:      e.Delay,
+		Fields:     e.Fields,
 		Links:      e.Links,
 		StackTrace: e.StackTrace,
 		Tags:       append(e.Tags, tags...),
 	}
 }
+
+// WithField returns a new copy of the Extras with the given key/value field
+// added, leaving any existing fields untouched.
+func (e Extras) WithField(k string, v any) Extras {
+	fields := make(map[string]any, len(e.Fields)+1)
+	for key, val := range e.Fields {
+		fields[key] = val
+	}
+	fields[k] = v
+
+	return Extras{
+		Delay:      e.Delay,
+		Fields:     fields,
+		Links:      e.Links,
+		StackTrace: e.StackTrace,
+		Tags:       e.Tags,
+	}
+}
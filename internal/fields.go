@@ -0,0 +1,44 @@
+package internal
+
+// Fields walks err's entire chain, merging every Canonical's Extras.Fields
+// into a single map. Where the same key appears at more than one level,
+// the outermost (closest to err) value wins.
+//
+// A *Group or chain encountered along the way is flattened into its
+// constituent errors rather than treated as a dead end, so that fields
+// attached to individual errors within a Join'd group are still found.
+func Fields(err error) map[string]any {
+	fields := make(map[string]any)
+	collectFields(err, fields)
+	return fields
+}
+
+// collectFields recursively walks err, merging fields into the given map.
+func collectFields(err error, fields map[string]any) {
+	for err != nil {
+		switch e := err.(type) {
+		case Canonical:
+			for k, v := range e.Extras.Fields {
+				if _, exists := fields[k]; !exists {
+					fields[k] = v
+				}
+			}
+		case *Group:
+			for _, ge := range e.Errors {
+				collectFields(ge, fields)
+			}
+			return
+		case chain:
+			for _, ce := range e {
+				collectFields(ce, fields)
+			}
+			return
+		}
+
+		u, ok := err.(HasUnwrap)
+		if !ok {
+			return
+		}
+		err = u.Unwrap()
+	}
+}
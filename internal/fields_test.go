@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFields_SingleCanonical(t *testing.T) {
+	c := Canonical{Namespace: "ns", Code: "c", Message: "m"}.WithField("a", 1)
+
+	got := Fields(c)
+	if got["a"] != 1 {
+		t.Errorf("Fields() = %v, want map[a:1]", got)
+	}
+}
+
+func TestFields_WrappedChainOuterWins(t *testing.T) {
+	inner := Canonical{Namespace: "ns", Code: "inner", Message: "inner"}.WithField("a", "inner-value").WithField("b", 2)
+	outer := Canonical{Namespace: "ns", Code: "outer", Message: "outer"}.Wrap(inner).(Canonical).WithField("a", "outer-value")
+
+	got := Fields(outer)
+	if got["a"] != "outer-value" {
+		t.Errorf("Fields()[a] = %v, want outer-value (outer should win on conflict)", got["a"])
+	}
+	if got["b"] != 2 {
+		t.Errorf("Fields()[b] = %v, want 2 (inherited from inner)", got["b"])
+	}
+}
+
+func TestFields_FlattensGroup(t *testing.T) {
+	e1 := Canonical{Namespace: "ns", Code: "c1", Message: "m1"}.WithField("a", 1)
+	e2 := Canonical{Namespace: "ns", Code: "c2", Message: "m2"}.WithField("b", 2)
+
+	g := NewGroup(e1, e2)
+
+	got := Fields(g)
+	if got["a"] != 1 || got["b"] != 2 {
+		t.Errorf("Fields() = %v, want map[a:1 b:2]", got)
+	}
+}
+
+func TestFields_FlattensChain(t *testing.T) {
+	e1 := Canonical{Namespace: "ns", Code: "c1", Message: "m1"}.WithField("a", 1)
+	e2 := Canonical{Namespace: "ns", Code: "c2", Message: "m2"}.WithField("b", 2)
+
+	joined := chain{e1, e2}
+
+	got := Fields(joined)
+	if got["a"] != 1 || got["b"] != 2 {
+		t.Errorf("Fields() = %v, want map[a:1 b:2]", got)
+	}
+}
+
+func TestFields_NoCanonicalInChain(t *testing.T) {
+	got := Fields(errors.New("plain"))
+	if len(got) != 0 {
+		t.Errorf("Fields() = %v, want empty map", got)
+	}
+}
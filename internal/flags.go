@@ -9,11 +9,39 @@ const (
 	FlagRetryable
 	// FlagTimeout is set to represent errors indicating a timeout occurred.
 	FlagTimeout
+	// FlagNotFound is set to represent errors where a resource could not be found.
+	FlagNotFound
+	// FlagInvalidArgument is set to represent errors caused by a bad request/argument.
+	FlagInvalidArgument
+	// FlagUnauthenticated is set to represent errors where the caller's identity
+	// could not be verified.
+	FlagUnauthenticated
+	// FlagPermissionDenied is set to represent errors where the caller is identified
+	// but not authorized to perform the operation.
+	FlagPermissionDenied
+	// FlagConflict is set to represent errors where the request conflicts with the
+	// current state of the resource.
+	FlagConflict
+	// FlagUnavailable is set to represent errors where the service is currently
+	// unable to handle the request.
+	FlagUnavailable
+	// FlagInternal is set to represent errors caused by an internal invariant
+	// being broken.
+	FlagInternal
+	// FlagResourceExhausted is set to represent errors where a quota or rate
+	// limit has been exceeded.
+	FlagResourceExhausted
+	// FlagFailedPrecondition is set to represent errors where the system is not
+	// in a state required to perform the operation.
+	FlagFailedPrecondition
+	// FlagCancelled is set to represent errors where the operation was cancelled,
+	// typically by the caller.
+	FlagCancelled
 )
 
-// Flags is a `uint8` with helper methods for bitwise operations
+// Flags is a `uint16` with helper methods for bitwise operations
 // to store additional properties about errors.
-type Flags uint8
+type Flags uint16
 
 // MarshalText implements the text marshaller method.
 func (f Flags) MarshalText() ([]byte, error) {
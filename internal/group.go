@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -17,8 +18,6 @@ func NewGroup(errs ...error) *Group {
 }
 
 // Group stores multiple Canonical instances.
-//
-// TODO(ahawker) Flatten JSON output to a single error when group only has one.
 type Group struct {
 	// Errors in the group.
 	Errors []Error `json:"errors"`
@@ -26,6 +25,22 @@ type Group struct {
 	Formatter GroupFormatter `json:"-"`
 }
 
+// MarshalJSON implements the json.Marshaler interface.
+//
+// When the group contains exactly one error, it is marshalled directly
+// instead of being wrapped in an "errors" array.
+func (g *Group) MarshalJSON() ([]byte, error) {
+	if g == nil {
+		return []byte("null"), nil
+	}
+	if len(g.Errors) == 1 {
+		return json.Marshal(g.Errors[0])
+	}
+	return json.Marshal(struct {
+		Errors []Error `json:"errors"`
+	}{Errors: g.Errors})
+}
+
 // Append adds a new error to the group.
 //
 // If one of the errors is a Group, it will be flatten into this group.
@@ -207,3 +222,46 @@ func GroupFormatterDefault(errors []Error) string {
 		return fmt.Sprintf("\n%s\n\n", strings.Join(points, "\n"))
 	}
 }
+
+// treeIndent is the indentation added per level of GroupFormatterTree output.
+const treeIndent = "   "
+
+// GroupFormatterTree is a Formatter that renders each error in the group,
+// along with its full Unwrap chain, as an indented tree, e.g.:
+//
+//	└─ [ns:code] outer message
+//	   └─ [ns:code] inner message
+func GroupFormatterTree(errors []Error) string {
+	var sb strings.Builder
+	for _, err := range errors {
+		writeTreeChain(&sb, err, 0)
+	}
+	return sb.String()
+}
+
+// writeTreeChain writes err, then walks its Unwrap chain writing one
+// indented line per level.
+func writeTreeChain(sb *strings.Builder, err error, depth int) {
+	for err != nil {
+		sb.WriteString(strings.Repeat(treeIndent, depth))
+		sb.WriteString("└─ ")
+		sb.WriteString(treeNodeLabel(err))
+		sb.WriteString("\n")
+
+		u, ok := err.(HasUnwrap)
+		if !ok {
+			break
+		}
+		err = u.Unwrap()
+		depth++
+	}
+}
+
+// treeNodeLabel renders a single tree node: "[namespace:code] message" for
+// a Canonical, or err.Error() otherwise.
+func treeNodeLabel(err error) string {
+	if ce, ok := err.(Canonical); ok {
+		return fmt.Sprintf("[%s:%s] %s", ce.Namespace, ce.Code, ce.Message)
+	}
+	return err.Error()
+}
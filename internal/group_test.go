@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestGroupFormatterTree(t *testing.T) {
+	outer := Canonical{Namespace: "ns", Code: "outer", Message: "outer failed"}
+	inner := Canonical{Namespace: "ns", Code: "inner", Message: "inner failed"}
+	chained := outer.Wrap(inner).(Canonical)
+
+	g := NewGroup()
+	g.Formatter = GroupFormatterTree
+	g.Append(chained)
+
+	want := "└─ [ns:outer] outer failed\n   └─ [ns:inner] inner failed\n"
+	if got := g.Error(); got != want {
+		t.Errorf("GroupFormatterTree() = %q, want %q", got, want)
+	}
+}
+
+func TestGroupFormatterTree_PlainWrappedError(t *testing.T) {
+	outer := Canonical{Namespace: "ns", Code: "outer", Message: "outer failed"}
+	chained := outer.Wrap(errors.New("plain")).(Canonical)
+
+	g := NewGroup(chained)
+	g.Formatter = GroupFormatterTree
+
+	want := "└─ [ns:outer] outer failed\n   └─ plain\n"
+	if got := g.Error(); got != want {
+		t.Errorf("GroupFormatterTree() = %q, want %q", got, want)
+	}
+}
+
+func TestGroupMarshalJSON_SingleErrorFlattened(t *testing.T) {
+	c := Canonical{Namespace: "ns", Code: "c", Message: "m"}
+	g := NewGroup(c)
+
+	b, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var out map[string]any
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if _, ok := out["errors"]; ok {
+		t.Errorf("single-error group should not be wrapped in \"errors\": %s", b)
+	}
+	if out["code"] != "c" {
+		t.Errorf("expected flattened error, got %s", b)
+	}
+}
+
+func TestGroupMarshalJSON_MultipleErrorsWrapped(t *testing.T) {
+	c1 := Canonical{Namespace: "ns", Code: "c1", Message: "m1"}
+	c2 := Canonical{Namespace: "ns", Code: "c2", Message: "m2"}
+	g := NewGroup(c1, c2)
+
+	b, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var out struct {
+		Errors []map[string]any `json:"errors"`
+	}
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(out.Errors) != 2 {
+		t.Fatalf("expected 2 errors, got %d: %s", len(out.Errors), b)
+	}
+}
+
+func TestCanonicalMarshalJSON_WrappedCanonical(t *testing.T) {
+	inner := Canonical{Namespace: "ns", Code: "inner", Message: "inner failed"}
+	outer := Canonical{Namespace: "ns", Code: "outer", Message: "outer failed"}.Wrap(inner)
+
+	b, err := json.Marshal(outer)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var out struct {
+		Wrapped struct {
+			Code string `json:"code"`
+		} `json:"wrapped"`
+	}
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if out.Wrapped.Code != "inner" {
+		t.Errorf("expected nested wrapped Canonical, got %s", b)
+	}
+}
+
+func TestCanonicalMarshalJSON_WrappedPlainError(t *testing.T) {
+	outer := Canonical{Namespace: "ns", Code: "outer", Message: "outer failed"}.Wrap(errors.New("plain"))
+
+	b, err := json.Marshal(outer)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var out struct {
+		Wrapped struct {
+			Message string `json:"message"`
+		} `json:"wrapped"`
+	}
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if out.Wrapped.Message != "plain" {
+		t.Errorf("expected {\"message\": \"plain\"}, got %s", b)
+	}
+}
@@ -0,0 +1,131 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Registry keeps track of Canonical errors, keyed by their Key() (namespace
+// + code), so that the full set of errors a service can return is
+// enumerable for docs/observability instead of living only in scattered
+// `Define` call-sites.
+type Registry struct {
+	mu     sync.RWMutex
+	errors map[string]Canonical
+}
+
+// NewRegistry creates a new, empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{errors: make(map[string]Canonical)}
+}
+
+// DefaultRegistry is the Registry used by the package-level Define function.
+var DefaultRegistry = NewRegistry()
+
+// Register adds c to the registry, keyed by its Key().
+//
+// It panics if an error with the same Key() has already been registered.
+// Register (and Define, which calls it) is meant to be invoked with
+// hard-coded arguments at package init time, so a duplicate key indicates
+// a programming error, not a condition callers should need to handle.
+func (r *Registry) Register(c Canonical) Canonical {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := c.Key()
+	if _, ok := r.errors[key]; ok {
+		panic(fmt.Sprintf("errors: duplicate error registered for key %q", key))
+	}
+	r.errors[key] = c
+	return c
+}
+
+// Lookup returns the Canonical registered for the given namespace and code.
+func (r *Registry) Lookup(namespace Namespace, code Code) (Canonical, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.errors[ErrorKey(namespace, code)]
+	return c, ok
+}
+
+// List returns every Canonical registered for the given namespace, sorted
+// by Code.
+func (r *Registry) List(namespace Namespace) []Canonical {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]Canonical, 0, len(r.errors))
+	for _, c := range r.errors {
+		if c.Namespace == namespace {
+			list = append(list, c)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list
+}
+
+// MarshalJSON implements the json.Marshaler interface, emitting every
+// registered error (across all namespaces) as a catalog, sorted by Key,
+// suitable for generating docs or an observability dashboard.
+func (r *Registry) MarshalJSON() ([]byte, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	list := make([]Canonical, 0, len(r.errors))
+	for _, c := range r.errors {
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Key() < list[j].Key() })
+	return json.Marshal(list)
+}
+
+// WithNamespace returns a NamespacedRegistry, a scoped view over r that
+// fixes the namespace for Register, Lookup, and List.
+func (r *Registry) WithNamespace(ns Namespace) NamespacedRegistry {
+	return NamespacedRegistry{registry: r, namespace: ns}
+}
+
+// NamespacedRegistry is a Registry view scoped to a single namespace.
+type NamespacedRegistry struct {
+	registry  *Registry
+	namespace Namespace
+}
+
+// Register adds c to the underlying Registry. It panics if c.Namespace
+// does not match the namespace this view is scoped to.
+func (n NamespacedRegistry) Register(c Canonical) Canonical {
+	if c.Namespace != n.namespace {
+		panic(fmt.Sprintf("errors: cannot register %q error via %q-scoped registry", c.Namespace, n.namespace))
+	}
+	return n.registry.Register(c)
+}
+
+// Lookup returns the Canonical registered for the given code in this namespace.
+func (n NamespacedRegistry) Lookup(code Code) (Canonical, bool) {
+	return n.registry.Lookup(n.namespace, code)
+}
+
+// List returns every Canonical registered in this namespace, sorted by Code.
+func (n NamespacedRegistry) List() []Canonical {
+	return n.registry.List(n.namespace)
+}
+
+// Define creates a new Canonical from the given namespace, code, message,
+// and flags, registers it in DefaultRegistry, and returns it.
+//
+// It panics if an error with the same {namespace, code} has already been
+// defined. Define is meant to be called once per error at package init
+// time (typically assigned to a package-level var), so two packages that
+// accidentally pick the same {namespace, code} fail loudly instead of
+// silently shadowing one another.
+func Define(namespace Namespace, code Code, message string, flags Flags) Canonical {
+	return DefaultRegistry.Register(Canonical{
+		Code:      code,
+		Flags:     flags,
+		Message:   message,
+		Namespace: namespace,
+	})
+}
@@ -0,0 +1,81 @@
+package internal
+
+import "testing"
+
+func TestRegistry_RegisterLookupList(t *testing.T) {
+	r := NewRegistry()
+
+	c1 := r.Register(Canonical{Namespace: "ns", Code: "c1", Message: "m1"})
+	c2 := r.Register(Canonical{Namespace: "ns", Code: "c2", Message: "m2"})
+	r.Register(Canonical{Namespace: "other", Code: "c1", Message: "m3"})
+
+	got, ok := r.Lookup("ns", "c1")
+	if !ok || got.Key() != c1.Key() {
+		t.Errorf("Lookup(ns, c1) = %v, %v, want %v, true", got, ok, c1)
+	}
+
+	if _, ok := r.Lookup("ns", "missing"); ok {
+		t.Errorf("Lookup(ns, missing) found an entry, want none")
+	}
+
+	list := r.List("ns")
+	if len(list) != 2 {
+		t.Fatalf("List(ns) returned %d entries, want 2", len(list))
+	}
+	if list[0].Code != c1.Code || list[1].Code != c2.Code {
+		t.Errorf("List(ns) = %v, want sorted by Code [c1, c2]", list)
+	}
+}
+
+func TestRegistry_RegisterDuplicatePanics(t *testing.T) {
+	r := NewRegistry()
+	r.Register(Canonical{Namespace: "ns", Code: "c1", Message: "m1"})
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Register() with duplicate key did not panic")
+		}
+	}()
+	r.Register(Canonical{Namespace: "ns", Code: "c1", Message: "different message"})
+}
+
+func TestNamespacedRegistry_ScopesRegisterLookupList(t *testing.T) {
+	r := NewRegistry()
+	ns := r.WithNamespace("ns")
+
+	ns.Register(Canonical{Namespace: "ns", Code: "c1", Message: "m1"})
+
+	if _, ok := ns.Lookup("c1"); !ok {
+		t.Errorf("NamespacedRegistry.Lookup(c1) not found")
+	}
+	if got, ok := r.Lookup("ns", "c1"); !ok || got.Code != "c1" {
+		t.Errorf("underlying Registry.Lookup(ns, c1) = %v, %v, want registered entry", got, ok)
+	}
+	if len(ns.List()) != 1 {
+		t.Errorf("NamespacedRegistry.List() = %d entries, want 1", len(ns.List()))
+	}
+}
+
+func TestNamespacedRegistry_RegisterWrongNamespacePanics(t *testing.T) {
+	r := NewRegistry()
+	ns := r.WithNamespace("ns")
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Register() with mismatched namespace did not panic")
+		}
+	}()
+	ns.Register(Canonical{Namespace: "other", Code: "c1", Message: "m1"})
+}
+
+func TestDefine_RegistersInDefaultRegistry(t *testing.T) {
+	c := Define("chunk0-4-test-ns", "defined", "defined message", FlagRetryable)
+
+	got, ok := DefaultRegistry.Lookup("chunk0-4-test-ns", "defined")
+	if !ok {
+		t.Fatalf("Define() did not register in DefaultRegistry")
+	}
+	if got.Key() != c.Key() || got.Message != "defined message" {
+		t.Errorf("DefaultRegistry.Lookup() = %v, want %v", got, c)
+	}
+}
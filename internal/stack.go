@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// stackCaptureEnabled controls whether New, Wrap, and Wrapf capture a
+// stack trace. Enabled by default.
+var stackCaptureEnabled int32 = 1
+
+// SetStackCaptureEnabled toggles whether a stack trace is captured when
+// errors are created via New or attached via Wrap/Wrapf. Disable this in
+// hot paths where the cost of runtime.Callers is undesirable.
+func SetStackCaptureEnabled(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&stackCaptureEnabled, 1)
+		return
+	}
+	atomic.StoreInt32(&stackCaptureEnabled, 0)
+}
+
+// StackCaptureEnabled returns true if stack trace capture is currently enabled.
+func StackCaptureEnabled() bool {
+	return atomic.LoadInt32(&stackCaptureEnabled) == 1
+}
+
+// Frame is a single resolved entry of a Stack.
+type Frame struct {
+	// Function is the name of the function containing this frame's program counter.
+	Function string
+	// File is the source file containing this frame's program counter.
+	File string
+	// Line is the line number within File for this frame's program counter.
+	Line int
+}
+
+// String returns the Frame in "function\n\tfile:line" form.
+func (f Frame) String() string {
+	return fmt.Sprintf("%s\n\t%s:%d", f.Function, f.File, f.Line)
+}
+
+// Stack is a raw slice of program counters captured via runtime.Callers.
+// Resolution to function/file/line is deferred to Frames so that the
+// common case of never formatting a stack stays cheap.
+type Stack []uintptr
+
+// captureStack captures the stack of the calling goroutine, skipping the
+// given number of frames above captureStack's own caller. It returns nil
+// if stack capture is disabled.
+func captureStack(skip int) Stack {
+	if !StackCaptureEnabled() {
+		return nil
+	}
+
+	var pcs [64]uintptr
+	n := runtime.Callers(skip+2, pcs[:])
+	return Stack(pcs[:n])
+}
+
+// Frames resolves the raw program counters into human-readable Frame values.
+func (s Stack) Frames() []Frame {
+	if len(s) == 0 {
+		return nil
+	}
+
+	frames := make([]Frame, 0, len(s))
+	cf := runtime.CallersFrames(s)
+	for {
+		frame, more := cf.Next()
+		frames = append(frames, Frame{
+			Function: frame.Function,
+			File:     frame.File,
+			Line:     frame.Line,
+		})
+		if !more {
+			break
+		}
+	}
+	return frames
+}
+
+// String renders the Stack as a multi-line list of resolved frames.
+func (s Stack) String() string {
+	frames := s.Frames()
+	lines := make([]string, len(frames))
+	for i, f := range frames {
+		lines[i] = f.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// chainStackTrace walks err's Wrapped chain and returns the deepest non-empty
+// Stack found, or nil if none of the Canonicals in the chain captured one.
+func chainStackTrace(err error) Stack {
+	var found Stack
+	for err != nil {
+		if ce, ok := err.(Canonical); ok && len(ce.Extras.StackTrace) > 0 {
+			found = ce.Extras.StackTrace
+		}
+
+		u, ok := err.(HasUnwrap)
+		if !ok {
+			break
+		}
+		err = u.Unwrap()
+	}
+	return found
+}
+
+// hasStackTrace returns true if err, or any Canonical in its chain, already
+// carries a captured Stack.
+func hasStackTrace(err error) bool {
+	return len(chainStackTrace(err)) > 0
+}
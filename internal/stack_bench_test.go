@@ -0,0 +1,37 @@
+package internal
+
+import (
+	"errors"
+	"testing"
+)
+
+// BenchmarkWrapStackCaptureEnabled measures the cost of Wrap when stack
+// capture is enabled (the default).
+func BenchmarkWrapStackCaptureEnabled(b *testing.B) {
+	SetStackCaptureEnabled(true)
+	defer SetStackCaptureEnabled(true)
+
+	c := Canonical{Namespace: "bench", Code: "wrap", Message: "m"}
+	err := errors.New("boom")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.Wrap(err)
+	}
+}
+
+// BenchmarkWrapStackCaptureDisabled measures the cost of Wrap with stack
+// capture disabled via SetStackCaptureEnabled, for comparison against
+// BenchmarkWrapStackCaptureEnabled.
+func BenchmarkWrapStackCaptureDisabled(b *testing.B) {
+	SetStackCaptureEnabled(false)
+	defer SetStackCaptureEnabled(true)
+
+	c := Canonical{Namespace: "bench", Code: "wrap", Message: "m"}
+	err := errors.New("boom")
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.Wrap(err)
+	}
+}
@@ -0,0 +1,159 @@
+// Package retry drives a retry loop off of a Canonical's FlagRetryable and
+// Extras.Delay, so callers don't have to hand-roll backoff logic around
+// every retryable operation.
+package retry
+
+import (
+	stderrors "errors"
+	"math/rand"
+	"time"
+
+	"context"
+
+	errors "github.com/go-stdlib/go-errors"
+)
+
+// DefaultMaxAttempts is the number of attempts Do makes before giving up,
+// including the initial attempt, unless overridden by WithMaxAttempts.
+const DefaultMaxAttempts = 5
+
+// Backoff computes the delay to wait before the next attempt, given the
+// zero-based index of the attempt that just failed.
+type Backoff interface {
+	Next(attempt int) time.Duration
+}
+
+// ConstantBackoff waits the same Delay before every attempt.
+type ConstantBackoff struct {
+	Delay time.Duration
+}
+
+// Next implements Backoff.
+func (b ConstantBackoff) Next(attempt int) time.Duration {
+	return b.Delay
+}
+
+// ExponentialBackoff doubles Base for every attempt, capped at Max, with up
+// to Jitter added at random to avoid thundering-herd retries.
+type ExponentialBackoff struct {
+	// Base is the delay used for the first retry.
+	Base time.Duration
+	// Max caps the computed delay. Zero means uncapped.
+	Max time.Duration
+	// Jitter adds a random duration in [0, Jitter) to the computed delay.
+	Jitter time.Duration
+}
+
+// Next implements Backoff.
+func (b ExponentialBackoff) Next(attempt int) time.Duration {
+	delay := b.Base << attempt
+	if b.Max > 0 && delay > b.Max {
+		delay = b.Max
+	}
+	if b.Jitter > 0 {
+		delay += time.Duration(rand.Int63n(int64(b.Jitter)))
+	}
+	return delay
+}
+
+// RetryBudget caps the total wall-clock time Do spends across all attempts
+// and sleeps, independent of MaxAttempts.
+type RetryBudget struct {
+	start time.Time
+	limit time.Duration
+}
+
+// NewRetryBudget creates a RetryBudget for limit, starting now.
+func NewRetryBudget(limit time.Duration) *RetryBudget {
+	return &RetryBudget{start: time.Now(), limit: limit}
+}
+
+// Remaining returns how much of the budget is left.
+func (b *RetryBudget) Remaining() time.Duration {
+	return b.limit - time.Since(b.start)
+}
+
+// options holds the configuration accumulated from Option values.
+type options struct {
+	maxAttempts int
+	backoff     Backoff
+	budget      *RetryBudget
+}
+
+// Option configures Do.
+type Option func(*options)
+
+// WithMaxAttempts overrides DefaultMaxAttempts.
+func WithMaxAttempts(n int) Option {
+	return func(o *options) { o.maxAttempts = n }
+}
+
+// WithBackoff overrides the Backoff used to compute delay between attempts.
+// The default is a ConstantBackoff with a zero Delay, so by default only
+// Extras.Delay from the Canonical itself drives the wait.
+func WithBackoff(b Backoff) Option {
+	return func(o *options) { o.backoff = b }
+}
+
+// WithBudget caps the total wall-clock time Do spends retrying.
+func WithBudget(budget *RetryBudget) Option {
+	return func(o *options) { o.budget = budget }
+}
+
+// Do calls fn, retrying as long as the returned error is a Canonical with
+// FlagRetryable set. Between attempts it sleeps for the larger of the
+// error's Extras.Delay and the configured Backoff's Next(attempt), capped
+// by ctx cancellation and any configured RetryBudget. Do gives up and
+// returns the last error once MaxAttempts is reached, the budget runs out,
+// or the error isn't retryable; if ctx is done while waiting between
+// attempts, it returns ctx.Err() instead.
+func Do(ctx context.Context, fn func(context.Context) error, opts ...Option) error {
+	cfg := options{
+		maxAttempts: DefaultMaxAttempts,
+		backoff:     ConstantBackoff{},
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.maxAttempts < 1 {
+		cfg.maxAttempts = 1
+	}
+
+	var err error
+	for attempt := 0; attempt < cfg.maxAttempts; attempt++ {
+		err = fn(ctx)
+		if err == nil {
+			return nil
+		}
+
+		var ce errors.Canonical
+		if !stderrors.As(err, &ce) || !ce.IsRetryable() {
+			return err
+		}
+
+		if attempt == cfg.maxAttempts-1 {
+			break
+		}
+
+		delay := cfg.backoff.Next(attempt)
+		if ce.Extras.Delay > delay {
+			delay = ce.Extras.Delay
+		}
+
+		if cfg.budget != nil {
+			if remaining := cfg.budget.Remaining(); remaining <= 0 {
+				break
+			} else if delay > remaining {
+				delay = remaining
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,114 @@
+package retry
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	errors "github.com/go-stdlib/go-errors"
+)
+
+func retryable(delay time.Duration) error {
+	return errors.New("boom").WithRetryable(delay)
+}
+
+func TestDo_SucceedsAfterRetries(t *testing.T) {
+	calls := 0
+	err := Do(context.Background(), func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return retryable(0)
+		}
+		return nil
+	}, WithMaxAttempts(5))
+
+	if err != nil {
+		t.Fatalf("Do() error = %v, want nil", err)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times, want 3", calls)
+	}
+}
+
+func TestDo_NonRetryableStopsImmediately(t *testing.T) {
+	calls := 0
+	want := stderrors.New("not retryable")
+	err := Do(context.Background(), func(context.Context) error {
+		calls++
+		return want
+	}, WithMaxAttempts(5))
+
+	if err != want {
+		t.Errorf("Do() error = %v, want %v", err, want)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+}
+
+func TestDo_ExhaustsMaxAttempts(t *testing.T) {
+	calls := 0
+	err := Do(context.Background(), func(context.Context) error {
+		calls++
+		return retryable(0)
+	}, WithMaxAttempts(3))
+
+	if err == nil {
+		t.Fatalf("Do() error = nil, want last error")
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times, want 3", calls)
+	}
+}
+
+func TestDo_MaxAttemptsZeroStillCallsFnOnce(t *testing.T) {
+	calls := 0
+	want := stderrors.New("only call")
+	err := Do(context.Background(), func(context.Context) error {
+		calls++
+		return want
+	}, WithMaxAttempts(0))
+
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1 (MaxAttempts<1 should clamp to 1)", calls)
+	}
+	if err != want {
+		t.Errorf("Do() error = %v, want %v", err, want)
+	}
+}
+
+func TestDo_BudgetExhaustedStopsRetrying(t *testing.T) {
+	calls := 0
+	budget := NewRetryBudget(0)
+	err := Do(context.Background(), func(context.Context) error {
+		calls++
+		return retryable(time.Millisecond)
+	}, WithMaxAttempts(5), WithBudget(budget))
+
+	if err == nil {
+		t.Fatalf("Do() error = nil, want last error")
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1 (budget already exhausted)", calls)
+	}
+}
+
+func TestDo_ContextCancelledDuringSleep(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := Do(ctx, func(context.Context) error {
+		calls++
+		if calls == 1 {
+			cancel()
+		}
+		return retryable(time.Hour)
+	}, WithMaxAttempts(5))
+
+	if !stderrors.Is(err, context.Canceled) {
+		t.Errorf("Do() error = %v, want context.Canceled", err)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+}